@@ -0,0 +1,285 @@
+package lru
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// expirableEntry is the internal data stored in each list.Element of an
+// ExpirableMemoria. A zero expires means the entry never expires.
+type expirableEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+func (e *expirableEntry[K, V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// ExpirableMemoria is a generic LRU cache that also bounds staleness: entries
+// may carry a TTL and are treated as misses once expired. It is safe for
+// concurrent use by multiple goroutines.
+type ExpirableMemoria[K comparable, V any] struct {
+	capacity   int
+	defaultTTL time.Duration
+	mu         sync.RWMutex
+	dict       map[K]*list.Element
+	ll         *list.List
+	len        int
+
+	ch   chan *list.Element
+	done chan struct{}
+
+	closed bool
+	once   sync.Once
+}
+
+// ExpirableOption configures an ExpirableMemoria created via NewExpirable.
+type ExpirableOption[K comparable, V any] func(*ExpirableMemoria[K, V])
+
+// WithDefaultTTL sets the TTL applied to entries inserted via Put (as opposed
+// to PutWithTTL, which sets its own TTL per entry). A zero TTL (the default)
+// means entries never expire unless PutWithTTL is used.
+func WithDefaultTTL[K comparable, V any](ttl time.Duration) ExpirableOption[K, V] {
+	return func(m *ExpirableMemoria[K, V]) {
+		m.defaultTTL = ttl
+	}
+}
+
+// NewExpirable creates a new ExpirableMemoria (TTL-aware LRU cache) with the
+// specified capacity. Returns an error if capacity is less than or equal to
+// zero.
+func NewExpirable[K comparable, V any](capacity int, opts ...ExpirableOption[K, V]) (*ExpirableMemoria[K, V], error) {
+	if capacity <= 0 {
+		return nil, errors.New("capacity must be greater than 0")
+	}
+	m := &ExpirableMemoria[K, V]{
+		capacity: capacity,
+		dict:     make(map[K]*list.Element, capacity),
+		ll:       list.New(),
+
+		ch:   make(chan *list.Element /* buffer size */, 1024),
+		done: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	go m.processEvents()
+	return m, nil
+}
+
+// Get returns the value associated with the given key if present and not
+// expired, and marks the entry as recently used. The second return value is
+// true if the key was found and live. An expired entry is treated as a miss
+// and removed from the cache.
+func (m *ExpirableMemoria[K, V]) Get(key K) (V, bool) {
+	// 1. check dict
+	m.mu.RLock()
+	if m.closed {
+		m.mu.RUnlock()
+		var zero V
+		return zero, false
+	}
+	ele, ok := m.dict[key]
+	m.mu.RUnlock()
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	ent := ele.Value.(*expirableEntry[K, V])
+	if ent.expired(time.Now()) {
+		m.mu.Lock()
+		if e, ok := m.dict[key]; ok && e == ele {
+			m.removeElement(ele)
+		}
+		m.mu.Unlock()
+		var zero V
+		return zero, false
+	}
+
+	// 2. send event to channel
+	select {
+	case m.ch <- ele:
+	case <-m.done:
+		var zero V
+		return zero, false
+	default:
+		// channel full, skip updating LRU order to avoid blocking
+		return ent.value, true
+	}
+
+	// 3. return value
+	return ent.value, true
+}
+
+// Put inserts or updates the key-value pair into the cache using the default
+// TTL configured via WithDefaultTTL (no expiration if none was configured).
+func (m *ExpirableMemoria[K, V]) Put(key K, value V) {
+	m.PutWithTTL(key, value, m.defaultTTL)
+}
+
+// PutWithTTL inserts or updates the key-value pair with a per-entry TTL. A
+// zero ttl means the entry never expires. If insertion causes the cache to
+// exceed its capacity, the least recently used entry is evicted, whether or
+// not it has expired.
+func (m *ExpirableMemoria[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	if ele, ok := m.dict[key]; ok {
+		m.ll.MoveToFront(ele)
+		ent := ele.Value.(*expirableEntry[K, V])
+		ent.value = value
+		ent.expires = expires
+		return
+	}
+
+	ent := &expirableEntry[K, V]{key: key, value: value, expires: expires}
+	ele := m.ll.PushFront(ent)
+	m.dict[key] = ele
+	m.len++
+
+	if m.len > m.capacity {
+		m.evict()
+	}
+}
+
+// Clear removes all entries from the cache.
+func (m *ExpirableMemoria[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	m.dict = make(map[K]*list.Element, m.capacity)
+	m.ll.Init()
+	m.len = 0
+}
+
+// Len returns the current number of entries in the cache, including any not
+// yet swept that have expired but have not been accessed.
+func (m *ExpirableMemoria[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.len
+}
+
+// Keys returns a slice of live (non-expired) keys ordered from least
+// recently used to most recently used. This function is mainly for testing
+// or debugging; it acquires a lock during execution.
+func (m *ExpirableMemoria[K, V]) Keys() []K {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil
+	}
+
+	now := time.Now()
+	keys := make([]K, 0, m.len)
+	for e := m.ll.Back(); e != nil; e = e.Prev() {
+		ent := e.Value.(*expirableEntry[K, V])
+		if ent.expired(now) {
+			continue
+		}
+		keys = append(keys, ent.key)
+	}
+	return keys
+}
+
+func (m *ExpirableMemoria[K, V]) Close() {
+	m.once.Do(func() {
+		m.mu.Lock()
+		m.closed = true
+		close(m.done)
+		m.mu.Unlock()
+	})
+}
+
+// processEvents both replays MRU-touch events from Get and periodically
+// sweeps the cache for expired entries, so staleness never outlives the
+// sweep interval even for keys that are never looked up again.
+func (m *ExpirableMemoria[K, V]) processEvents() {
+	const sweepInterval = time.Second
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case ele := <-m.ch:
+			m.mu.Lock()
+			if ele.Prev() != nil {
+				m.ll.MoveToFront(ele)
+			}
+			m.mu.Unlock()
+		case <-ticker.C:
+			m.sweep()
+		case <-m.done:
+			m.mu.Lock()
+			m.ch = nil
+			m.dict = nil
+			m.ll = nil
+			m.len = 0
+			m.mu.Unlock()
+			return
+		}
+	}
+}
+
+// sweep drops expired entries. List order reflects LRU recency, not expiry
+// (PutWithTTL lets each entry carry its own TTL independent of how recently
+// it was touched), so every entry must be checked rather than stopping at
+// the first live one found.
+func (m *ExpirableMemoria[K, V]) sweep() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	now := time.Now()
+	for e := m.ll.Back(); e != nil; {
+		ent := e.Value.(*expirableEntry[K, V])
+		prev := e.Prev()
+		if ent.expired(now) {
+			m.removeElement(e)
+		}
+		e = prev
+	}
+}
+
+// removeElement removes ele from the list and dict. It must be called with
+// the lock held.
+func (m *ExpirableMemoria[K, V]) removeElement(ele *list.Element) {
+	m.ll.Remove(ele)
+	ent := ele.Value.(*expirableEntry[K, V])
+	delete(m.dict, ent.key)
+	m.len--
+}
+
+// evict removes the least recently used entry (from the back of the list).
+// It must be called with the lock held.
+func (m *ExpirableMemoria[K, V]) evict() {
+	ele := m.ll.Back()
+	if ele == nil {
+		return
+	}
+	m.removeElement(ele)
+}
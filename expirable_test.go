@@ -0,0 +1,61 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpirablePutWithTTL(t *testing.T) {
+	cache, err := NewExpirable[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.PutWithTTL("a", 1, 10*time.Millisecond)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected found (1), got (%v, %v)", v, ok)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if v, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to have expired, but got %v", v)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected len 0 after expiry, got %d", cache.Len())
+	}
+}
+
+func TestExpirableDefaultTTL(t *testing.T) {
+	cache, err := NewExpirable[string, int](2, WithDefaultTTL[string, int](10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	time.Sleep(20 * time.Millisecond)
+
+	if v, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to have expired, but got %v", v)
+	}
+}
+
+func TestExpirableKeysSkipExpired(t *testing.T) {
+	cache, err := NewExpirable[string, int](3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.PutWithTTL("a", 1, 10*time.Millisecond)
+	cache.Put("b", 2) // no TTL, never expires
+
+	time.Sleep(20 * time.Millisecond)
+
+	keys := cache.Keys()
+	if len(keys) != 1 || keys[0] != "b" {
+		t.Errorf("expected keys [b], got %v", keys)
+	}
+}
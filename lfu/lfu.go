@@ -0,0 +1,227 @@
+// Package lfu implements an O(1) LFU (Least Frequently Used) cache, a
+// frequency-aware sibling of lru.Memoria for workloads where recency alone
+// evicts hot keys too aggressively.
+package lfu
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+// entry is the internal data stored in each freqNode's entries list.
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	freqElem *list.Element // the freqNode element this entry currently lives under
+}
+
+// freqNode groups every entry that has been accessed exactly freq times.
+type freqNode[K comparable, V any] struct {
+	freq    int
+	entries *list.List // of *entry[K,V]; front = most recently touched
+}
+
+// Cache is a generic LFU cache that holds keys of type K and values of type
+// V. Eviction picks the least frequently used entry, breaking ties by
+// recency. It is safe for concurrent use by multiple goroutines.
+type Cache[K comparable, V any] struct {
+	capacity int
+	mu       sync.Mutex
+	dict     map[K]*list.Element
+	freqList *list.List // of *freqNode[K,V]; front = lowest frequency
+	len      int
+
+	onEvict func(K, V)
+
+	closed bool
+	once   sync.Once
+}
+
+// Option configures a Cache created via New.
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// WithEvictCallback registers a function that is called whenever an entry is
+// evicted to make room for a new one.
+func WithEvictCallback[K comparable, V any](cb func(K, V)) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.onEvict = cb
+	}
+}
+
+// New creates a new Cache (LFU cache) with the specified capacity.
+// Returns an error if capacity is less than or equal to zero.
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) (*Cache[K, V], error) {
+	if capacity <= 0 {
+		return nil, errors.New("capacity must be greater than 0")
+	}
+	c := &Cache[K, V]{
+		capacity: capacity,
+		dict:     make(map[K]*list.Element, capacity),
+		freqList: list.New(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Get returns the value associated with the given key if present, and bumps
+// its frequency by one. The second return value is true if the key was
+// found. If the key is not present, returns (zero value, false).
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		var zero V
+		return zero, false
+	}
+
+	ele, ok := c.dict[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	ent := ele.Value.(*entry[K, V])
+	c.touch(ent)
+	return ent.value, true
+}
+
+// Put inserts or updates the key-value pair into the cache. If the key
+// already exists, its value is updated and its frequency is bumped.
+// Otherwise, if the cache is already at capacity, the tail entry of the
+// lowest-frequency node is evicted first, and then a new entry is inserted
+// at frequency 1.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+
+	if ele, ok := c.dict[key]; ok {
+		ent := ele.Value.(*entry[K, V])
+		ent.value = value
+		c.touch(ent)
+		return
+	}
+
+	if c.len >= c.capacity {
+		c.evict()
+	}
+
+	fe := c.freqList.Front()
+	if fe == nil || fe.Value.(*freqNode[K, V]).freq != 1 {
+		fe = c.freqList.PushFront(&freqNode[K, V]{freq: 1, entries: list.New()})
+	}
+	fn := fe.Value.(*freqNode[K, V])
+
+	ent := &entry[K, V]{key: key, value: value, freqElem: fe}
+	ele := fn.entries.PushFront(ent)
+	c.dict[key] = ele
+	c.len++
+}
+
+// touch moves ent to the freq+1 node, creating it if missing and removing
+// the old freq node if it becomes empty. It must be called with the lock
+// held.
+func (c *Cache[K, V]) touch(ent *entry[K, V]) {
+	oldFe := ent.freqElem
+	oldFn := oldFe.Value.(*freqNode[K, V])
+	oldFn.entries.Remove(c.dict[ent.key])
+
+	newFreq := oldFn.freq + 1
+	newFe := oldFe.Next()
+	if newFe == nil || newFe.Value.(*freqNode[K, V]).freq != newFreq {
+		newFe = c.freqList.InsertAfter(&freqNode[K, V]{freq: newFreq, entries: list.New()}, oldFe)
+	}
+	newFn := newFe.Value.(*freqNode[K, V])
+
+	ele := newFn.entries.PushFront(ent)
+	c.dict[ent.key] = ele
+	ent.freqElem = newFe
+
+	if oldFn.entries.Len() == 0 {
+		c.freqList.Remove(oldFe)
+	}
+}
+
+// evict removes the tail (least recently touched) entry of the
+// lowest-frequency node. It must be called with the lock held.
+func (c *Cache[K, V]) evict() {
+	fe := c.freqList.Front()
+	if fe == nil {
+		return
+	}
+	fn := fe.Value.(*freqNode[K, V])
+
+	tail := fn.entries.Back()
+	if tail == nil {
+		return
+	}
+	ent := tail.Value.(*entry[K, V])
+	fn.entries.Remove(tail)
+	delete(c.dict, ent.key)
+	c.len--
+
+	if fn.entries.Len() == 0 {
+		c.freqList.Remove(fe)
+	}
+
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
+
+// Clear removes all entries from the cache.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	c.dict = make(map[K]*list.Element, c.capacity)
+	c.freqList = list.New()
+	c.len = 0
+}
+
+// Len returns the current number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.len
+}
+
+// Keys returns a slice of keys ordered from least frequently/recently used
+// to most frequently/recently used: ascending by frequency, and within a
+// frequency from least to most recently touched. This function is mainly
+// for testing or debugging; it acquires a lock during execution.
+func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	keys := make([]K, 0, c.len)
+	for fe := c.freqList.Front(); fe != nil; fe = fe.Next() {
+		fn := fe.Value.(*freqNode[K, V])
+		for e := fn.entries.Back(); e != nil; e = e.Prev() {
+			ent := e.Value.(*entry[K, V])
+			keys = append(keys, ent.key)
+		}
+	}
+	return keys
+}
+
+func (c *Cache[K, V]) Close() {
+	c.once.Do(func() {
+		c.mu.Lock()
+		c.closed = true
+		c.mu.Unlock()
+	})
+}
@@ -0,0 +1,138 @@
+package lfu
+
+import "testing"
+
+func TestTiesBrokenByRecencyWithinFrequency(t *testing.T) {
+	cache, err := New[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	// a and b now share frequency 2; a was touched first so it is the tail
+	// (least recently touched) of that frequency node's list.
+	cache.Get("a")
+	cache.Get("b")
+
+	cache.Put("c", 3)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to be evicted as the older of the two equal-frequency entries")
+	}
+	if v, ok := cache.Get("b"); !ok || v != 2 {
+		t.Errorf("expected b to remain, got (%v, %v)", v, ok)
+	}
+}
+
+func TestTouchMovesEntryToNextFrequencyNode(t *testing.T) {
+	cache, err := New[string, int](3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+
+	// Bump a to frequency 2, then 3, creating fresh nodes for 2 and 3 along
+	// the way and leaving the frequency-2 node empty and removed.
+	cache.Get("a")
+	cache.Get("a")
+
+	// b and c are still at frequency 1, so either is evicted first; put two
+	// more keys to push both out while a (frequency 3) survives.
+	cache.Put("d", 4)
+	cache.Put("e", 5)
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected a to survive thanks to its higher frequency")
+	}
+}
+
+func TestEvictsLeastFrequentlyUsed(t *testing.T) {
+	cache, err := New[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	// Access a twice so it has a higher frequency than b.
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+
+	cache.Put("c", 3)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected b to be evicted (lowest frequency)")
+	}
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected a to remain, got (%v, %v)", v, ok)
+	}
+	if v, ok := cache.Get("c"); !ok || v != 3 {
+		t.Errorf("expected c to exist, got (%v, %v)", v, ok)
+	}
+}
+
+func TestEvictCallback(t *testing.T) {
+	var evictedKey string
+	var evictedValue int
+
+	cache, err := New[string, int](1, WithEvictCallback(func(k string, v int) {
+		evictedKey = k
+		evictedValue = v
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	if evictedKey != "a" || evictedValue != 1 {
+		t.Errorf("expected evict callback for (a, 1), got (%v, %v)", evictedKey, evictedValue)
+	}
+}
+
+func TestClearResetsFrequencyNodes(t *testing.T) {
+	cache, err := New[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Get("a") // a now sits in a frequency-3 node
+	cache.Clear()
+
+	if cache.Len() != 0 {
+		t.Errorf("expected len 0 after Clear, got %d", cache.Len())
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to be gone after Clear")
+	}
+
+	// Clear must drop the old frequency nodes entirely, not just empty the
+	// dict: a freshly inserted key should start back at frequency 1 and be
+	// the first evicted, rather than inheriting a's stale frequency-3 node.
+	cache.Put("x", 10)
+	cache.Put("y", 20)
+	cache.Put("z", 30)
+
+	if _, ok := cache.Get("x"); ok {
+		t.Errorf("expected x to be evicted as the oldest frequency-1 entry")
+	}
+	if v, ok := cache.Get("y"); !ok || v != 20 {
+		t.Errorf("expected y to remain, got (%v, %v)", v, ok)
+	}
+}
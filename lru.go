@@ -24,13 +24,15 @@ type Memoria[K comparable, V any] struct {
 	ch   chan *list.Element
 	done chan struct{}
 
+	onEvict func(K, V)
+
 	closed bool
 	once   sync.Once
 }
 
 // New creates a new Memoria (LRU cache) with the specified capacity.
 // Panics if capacity is less than or equal to zero.
-func New[K comparable, V any](capacity int) (*Memoria[K, V], error) {
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) (*Memoria[K, V], error) {
 	if capacity <= 0 {
 		return nil, errors.New("capacity must be greater than 0")
 	}
@@ -42,6 +44,9 @@ func New[K comparable, V any](capacity int) (*Memoria[K, V], error) {
 		ch:   make(chan *list.Element /* buffer size */, 1024),
 		done: make(chan struct{}),
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
 
 	go m.processEvents()
 	return m, nil
@@ -111,7 +116,8 @@ func (m *Memoria[K, V]) Put(key K, value V) {
 	}
 }
 
-// Clear removes all entries from the cache.
+// Clear removes all entries from the cache, firing the evict callback (if
+// any) for each one.
 func (m *Memoria[K, V]) Clear() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -120,6 +126,13 @@ func (m *Memoria[K, V]) Clear() {
 		return
 	}
 
+	if m.onEvict != nil {
+		for e := m.ll.Front(); e != nil; e = e.Next() {
+			ent := e.Value.(*entry[K, V])
+			m.onEvict(ent.key, ent.value)
+		}
+	}
+
 	m.dict = make(map[K]*list.Element, m.capacity)
 	m.ll.Init()
 	m.len = 0
@@ -193,4 +206,8 @@ func (m *Memoria[K, V]) evict() {
 	ent := ele.Value.(*entry[K, V])
 	delete(m.dict, ent.key)
 	m.len--
+
+	if m.onEvict != nil {
+		m.onEvict(ent.key, ent.value)
+	}
 }
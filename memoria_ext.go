@@ -0,0 +1,112 @@
+package lru
+
+import "errors"
+
+// Option configures a Memoria created via New.
+type Option[K comparable, V any] func(*Memoria[K, V])
+
+// WithEvictCallback registers a function that is called whenever an entry is
+// evicted or removed, whether by Put exceeding capacity, Resize shrinking
+// the cache, or an explicit Remove/Clear.
+func WithEvictCallback[K comparable, V any](cb func(K, V)) Option[K, V] {
+	return func(m *Memoria[K, V]) {
+		m.onEvict = cb
+	}
+}
+
+// Peek returns the value associated with the given key if present, without
+// marking the entry as recently used and without enqueuing an event to ch.
+// The second return value is true if the key was found.
+func (m *Memoria[K, V]) Peek(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.closed {
+		var zero V
+		return zero, false
+	}
+
+	ele, ok := m.dict[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return ele.Value.(*entry[K, V]).value, true
+}
+
+// Contains reports whether the given key is present in the cache, without
+// affecting its recency.
+func (m *Memoria[K, V]) Contains(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.closed {
+		return false
+	}
+	_, ok := m.dict[key]
+	return ok
+}
+
+// Remove deletes the given key from the cache, firing the evict callback (if
+// any). It reports whether the key was present.
+func (m *Memoria[K, V]) Remove(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return false
+	}
+
+	ele, ok := m.dict[key]
+	if !ok {
+		return false
+	}
+
+	m.ll.Remove(ele)
+	ent := ele.Value.(*entry[K, V])
+	delete(m.dict, ent.key)
+	m.len--
+
+	if m.onEvict != nil {
+		m.onEvict(ent.key, ent.value)
+	}
+	return true
+}
+
+// Resize changes the cache's capacity. If newCap is smaller than the
+// current length, the least recently used entries are evicted until the
+// cache fits. Returns an error if newCap is less than or equal to zero,
+// matching the capacity validation of New. It returns the number of entries
+// evicted.
+func (m *Memoria[K, V]) Resize(newCap int) (int, error) {
+	if newCap <= 0 {
+		return 0, errors.New("capacity must be greater than 0")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return 0, nil
+	}
+
+	m.capacity = newCap
+
+	evicted := 0
+	for m.len > m.capacity {
+		ele := m.ll.Back()
+		if ele == nil {
+			break
+		}
+		m.ll.Remove(ele)
+		ent := ele.Value.(*entry[K, V])
+		delete(m.dict, ent.key)
+		m.len--
+		evicted++
+
+		if m.onEvict != nil {
+			m.onEvict(ent.key, ent.value)
+		}
+	}
+	return evicted, nil
+}
@@ -0,0 +1,133 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeekDoesNotAffectRecency(t *testing.T) {
+	cache, err := New[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	if v, ok := cache.Peek("a"); !ok || v != 1 {
+		t.Errorf("expected found (1), got (%v, %v)", v, ok)
+	}
+
+	// a should still be the LRU entry since Peek must not touch recency.
+	cache.Put("c", 3)
+	if _, ok := cache.Peek("a"); ok {
+		t.Errorf("expected a to be evicted despite the Peek")
+	}
+}
+
+func TestContains(t *testing.T) {
+	cache, err := New[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	if cache.Contains("a") {
+		t.Errorf("expected a to be absent")
+	}
+	cache.Put("a", 1)
+	if !cache.Contains("a") {
+		t.Errorf("expected a to be present")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	var evictedKey string
+	cache, err := New[string, int](2, WithEvictCallback(func(k string, v int) {
+		evictedKey = k
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	if !cache.Remove("a") {
+		t.Errorf("expected Remove to report true for present key")
+	}
+	if cache.Remove("a") {
+		t.Errorf("expected Remove to report false for already-removed key")
+	}
+	if evictedKey != "a" {
+		t.Errorf("expected evict callback for a, got %q", evictedKey)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected len 0, got %d", cache.Len())
+	}
+}
+
+func TestResizeShrinks(t *testing.T) {
+	cache, err := New[string, int](4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Put("d", 4)
+
+	evicted, err := cache.Resize(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if evicted != 2 {
+		t.Errorf("expected 2 entries evicted, got %d", evicted)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected len 2, got %d", cache.Len())
+	}
+	// a and b were the LRU entries and should be gone.
+	if cache.Contains("a") || cache.Contains("b") {
+		t.Errorf("expected a and b to be evicted by Resize")
+	}
+	if !cache.Contains("c") || !cache.Contains("d") {
+		t.Errorf("expected c and d to remain after Resize")
+	}
+}
+
+func TestResizeRejectsNonPositiveCapacity(t *testing.T) {
+	cache, err := New[string, int](2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	if _, err := cache.Resize(0); err == nil {
+		t.Errorf("expected error for non-positive capacity")
+	}
+}
+
+func TestWithEvictCallbackOnPut(t *testing.T) {
+	var evictedKey string
+	var evictedValue int
+	cache, err := New[string, int](1, WithEvictCallback(func(k string, v int) {
+		evictedKey = k
+		evictedValue = v
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	time.Sleep(10 * time.Millisecond)
+
+	if evictedKey != "a" || evictedValue != 1 {
+		t.Errorf("expected evict callback for (a, 1), got (%v, %v)", evictedKey, evictedValue)
+	}
+}
@@ -0,0 +1,152 @@
+package lru
+
+import (
+	"errors"
+	"hash/maphash"
+)
+
+// ShardedMemoria fronts N independent Memoria instances chosen by a hash of
+// the key, so that the single sync.RWMutex a plain Memoria relies on stops
+// being the bottleneck under high-concurrency, many-core workloads.
+type ShardedMemoria[K comparable, V any] struct {
+	shards []*Memoria[K, V]
+	hash   func(K) uint64
+	seed   maphash.Seed
+}
+
+// ShardedOption configures a ShardedMemoria created via NewSharded.
+type ShardedOption[K comparable, V any] func(*ShardedMemoria[K, V])
+
+// WithHash overrides the default hash used to pick a shard for a key. This
+// is required for key types other than string and []byte, which are hashed
+// via hash/maphash by default.
+func WithHash[K comparable, V any](hash func(K) uint64) ShardedOption[K, V] {
+	return func(s *ShardedMemoria[K, V]) {
+		s.hash = hash
+	}
+}
+
+// NewSharded creates a ShardedMemoria with shardCount independent Memoria
+// shards, distributing capacity evenly across them (any remainder goes to
+// the first shards). Returns an error if capacity or shardCount is less than
+// or equal to zero, or if shardCount exceeds capacity (which would force
+// some shard's capacity below 1). For K other than string or []byte, a Hash
+// func must be supplied via WithHash, or NewSharded returns an error.
+func NewSharded[K comparable, V any](capacity, shardCount int, opts ...ShardedOption[K, V]) (*ShardedMemoria[K, V], error) {
+	if capacity <= 0 {
+		return nil, errors.New("capacity must be greater than 0")
+	}
+	if shardCount <= 0 {
+		return nil, errors.New("shardCount must be greater than 0")
+	}
+	if shardCount > capacity {
+		return nil, errors.New("shardCount must not exceed capacity")
+	}
+
+	s := &ShardedMemoria[K, V]{
+		shards: make([]*Memoria[K, V], shardCount),
+		seed:   maphash.MakeSeed(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.hash == nil {
+		h, err := defaultHash[K](s.seed)
+		if err != nil {
+			return nil, err
+		}
+		s.hash = h
+	}
+
+	base := capacity / shardCount
+	remainder := capacity % shardCount
+	for i := range s.shards {
+		shardCap := base
+		if i < remainder {
+			shardCap++
+		}
+		m, err := New[K, V](shardCap)
+		if err != nil {
+			return nil, err
+		}
+		s.shards[i] = m
+	}
+	return s, nil
+}
+
+// defaultHash builds a hash/maphash-backed hash function for string and
+// []byte keys. Other key types require WithHash.
+func defaultHash[K comparable](seed maphash.Seed) (func(K) uint64, error) {
+	var zero K
+	switch any(zero).(type) {
+	case string:
+		return func(k K) uint64 {
+			var h maphash.Hash
+			h.SetSeed(seed)
+			h.WriteString(any(k).(string))
+			return h.Sum64()
+		}, nil
+	case []byte:
+		return func(k K) uint64 {
+			var h maphash.Hash
+			h.SetSeed(seed)
+			h.Write(any(k).([]byte))
+			return h.Sum64()
+		}, nil
+	default:
+		return nil, errors.New("key type requires WithHash(...) to be supplied explicitly")
+	}
+}
+
+// shardFor picks the shard index for key.
+func (s *ShardedMemoria[K, V]) shardFor(key K) *Memoria[K, V] {
+	idx := s.hash(key) % uint64(len(s.shards))
+	return s.shards[idx]
+}
+
+// Get returns the value associated with the given key if present, and marks
+// the entry as recently used in its shard. The second return value is true
+// if the key was found.
+func (s *ShardedMemoria[K, V]) Get(key K) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Put inserts or updates the key-value pair in its shard. If insertion
+// causes that shard to exceed its capacity, the shard evicts its own least
+// recently used entry.
+func (s *ShardedMemoria[K, V]) Put(key K, value V) {
+	s.shardFor(key).Put(key, value)
+}
+
+// Clear removes all entries from every shard.
+func (s *ShardedMemoria[K, V]) Clear() {
+	for _, shard := range s.shards {
+		shard.Clear()
+	}
+}
+
+// Len returns the total number of entries across all shards.
+func (s *ShardedMemoria[K, V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
+
+// Keys returns the keys of every shard concatenated in shard order; within
+// a shard, keys are ordered from least recently used to most recently used.
+func (s *ShardedMemoria[K, V]) Keys() []K {
+	keys := make([]K, 0, s.Len())
+	for _, shard := range s.shards {
+		keys = append(keys, shard.Keys()...)
+	}
+	return keys
+}
+
+// Close shuts down every shard.
+func (s *ShardedMemoria[K, V]) Close() {
+	for _, shard := range s.shards {
+		shard.Close()
+	}
+}
@@ -0,0 +1,83 @@
+package lru
+
+import "testing"
+
+func TestShardedBasicPutGet(t *testing.T) {
+	cache, err := NewSharded[string, int](16, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	if v, ok := cache.Get("a"); ok {
+		t.Errorf("expected not found, but got %v", v)
+	}
+
+	cache.Put("a", 1)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected found (1), got (%v, %v)", v, ok)
+	}
+}
+
+func TestShardedDistributesCapacity(t *testing.T) {
+	cache, err := NewSharded[string, int](10, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	total := 0
+	for _, shard := range cache.shards {
+		total += shard.capacity
+	}
+	if total != 10 {
+		t.Errorf("expected shard capacities to sum to 10, got %d", total)
+	}
+}
+
+func TestShardedLenAndClear(t *testing.T) {
+	cache, err := NewSharded[int, int](16, 4, WithHash[int, int](func(k int) uint64 {
+		return uint64(k)
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	for i := 0; i < 8; i++ {
+		cache.Put(i, i*10)
+	}
+	if cache.Len() != 8 {
+		t.Errorf("expected len 8, got %d", cache.Len())
+	}
+
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Errorf("expected len 0 after Clear, got %d", cache.Len())
+	}
+}
+
+func TestShardedRejectsShardCountExceedingCapacity(t *testing.T) {
+	if _, err := NewSharded[string, int](3, 10); err == nil {
+		t.Errorf("expected error when shardCount exceeds capacity")
+	}
+}
+
+func TestShardedRequiresHashForNonStringKeys(t *testing.T) {
+	if _, err := NewSharded[int, int](16, 4); err == nil {
+		t.Errorf("expected error for int keys without WithHash")
+	}
+
+	cache, err := NewSharded[int, int](16, 4, WithHash[int, int](func(k int) uint64 {
+		return uint64(k)
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put(1, 10)
+	if v, ok := cache.Get(1); !ok || v != 10 {
+		t.Errorf("expected found (10), got (%v, %v)", v, ok)
+	}
+}
@@ -0,0 +1,185 @@
+// Package sieve implements the SIEVE cache eviction algorithm as a drop-in
+// alternative to lru.Memoria.
+package sieve
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+// entry is the internal data stored in each list.Element.
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	visited bool
+}
+
+// Sieve is a generic cache that holds keys of type K and values of type V,
+// evicted using the SIEVE algorithm instead of strict LRU. It is safe for
+// concurrent use by multiple goroutines.
+//
+// Unlike Memoria, a Get does not reorder entries: it only flips a visited
+// bit, so there is no need for the async ch/processEvents machinery. All
+// bookkeeping happens under a single mutex.
+type Sieve[K comparable, V any] struct {
+	capacity int
+	mu       sync.Mutex
+	dict     map[K]*list.Element
+	ll       *list.List // front = most recently inserted, back = oldest
+	hand     *list.Element
+	len      int
+
+	closed bool
+	once   sync.Once
+}
+
+// New creates a new Sieve cache with the specified capacity.
+// Returns an error if capacity is less than or equal to zero.
+func New[K comparable, V any](capacity int) (*Sieve[K, V], error) {
+	if capacity <= 0 {
+		return nil, errors.New("capacity must be greater than 0")
+	}
+	return &Sieve[K, V]{
+		capacity: capacity,
+		dict:     make(map[K]*list.Element, capacity),
+		ll:       list.New(),
+	}, nil
+}
+
+// Get returns the value associated with the given key if present, and marks
+// the entry as visited. The second return value is true if the key was
+// found. If the key is not present, returns (zero value, false).
+func (s *Sieve[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		var zero V
+		return zero, false
+	}
+
+	ele, ok := s.dict[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	ent := ele.Value.(*entry[K, V])
+	ent.visited = true
+	return ent.value, true
+}
+
+// Put inserts or updates the key-value pair into the cache. If the key
+// already exists, its value is updated and it is marked visited. Otherwise,
+// if the cache is already at capacity, an entry is first evicted per the
+// SIEVE algorithm, and then a new entry is inserted at the head.
+func (s *Sieve[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	if ele, ok := s.dict[key]; ok {
+		ent := ele.Value.(*entry[K, V])
+		ent.value = value
+		ent.visited = true
+		return
+	}
+
+	if s.len >= s.capacity {
+		s.evict()
+	}
+
+	ent := &entry[K, V]{key: key, value: value}
+	ele := s.ll.PushFront(ent)
+	s.dict[key] = ele
+	s.len++
+}
+
+// Clear removes all entries from the cache.
+func (s *Sieve[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.dict = make(map[K]*list.Element, s.capacity)
+	s.ll.Init()
+	s.hand = nil
+	s.len = 0
+}
+
+// Len returns the current number of entries in the cache.
+func (s *Sieve[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.len
+}
+
+// Keys returns a slice of keys ordered from oldest inserted to most recently
+// inserted. This function is mainly for testing or debugging; it acquires a
+// lock during execution.
+func (s *Sieve[K, V]) Keys() []K {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	keys := make([]K, 0, s.len)
+	for e := s.ll.Back(); e != nil; e = e.Prev() {
+		ent := e.Value.(*entry[K, V])
+		keys = append(keys, ent.key)
+	}
+	return keys
+}
+
+func (s *Sieve[K, V]) Close() {
+	s.once.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+	})
+}
+
+// evict walks the hand backward (towards the front, i.e. the most recently
+// inserted end) from its current position, wrapping around to the back when
+// it runs off the front, clearing visited bits on entries it passes. It
+// evicts the first entry it finds with visited == false, leaving the hand at
+// that entry's predecessor for the next call.
+//
+// It must be called with the lock held.
+func (s *Sieve[K, V]) evict() {
+	h := s.hand
+	if h == nil {
+		h = s.ll.Back()
+	}
+
+	for h != nil {
+		ent := h.Value.(*entry[K, V])
+		if !ent.visited {
+			prev := h.Prev()
+			if prev == nil {
+				prev = s.ll.Back()
+				if prev == h {
+					prev = nil
+				}
+			}
+			s.ll.Remove(h)
+			delete(s.dict, ent.key)
+			s.len--
+			s.hand = prev
+			return
+		}
+		ent.visited = false
+		prev := h.Prev()
+		if prev == nil {
+			prev = s.ll.Back()
+		}
+		h = prev
+	}
+}
@@ -0,0 +1,245 @@
+package lru
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+// twoQEntry is the internal data stored in each list.Element of the recent
+// and frequent lists of a TwoQCache. The recentEvict (ghost) list only
+// tracks keys, so it stores K directly instead.
+type twoQEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// TwoQCache is a scan-resistant cache that complements the pure-LRU Memoria
+// for workloads with large one-shot scans. It maintains three internal
+// lists: recent (LRU of one-hit items), frequent (LRU of items promoted
+// after a second hit), and recentEvict (a ghost LRU of keys evicted from
+// recent, values not stored). It is safe for concurrent use by multiple
+// goroutines.
+type TwoQCache[K comparable, V any] struct {
+	capacity    int
+	recentCap   int
+	ghostCap    int
+	mu          sync.Mutex
+	recent      *list.List // of *twoQEntry[K,V]
+	frequent    *list.List // of *twoQEntry[K,V]
+	recentEvict *list.List // of K
+
+	recentDict      map[K]*list.Element
+	frequentDict    map[K]*list.Element
+	recentEvictDict map[K]*list.Element
+
+	closed bool
+	once   sync.Once
+}
+
+// New2Q creates a new TwoQCache with the given total capacity. recentRatio
+// bounds the size of the recent list as a fraction of capacity, and
+// ghostRatio bounds the size of the recentEvict ghost list the same way.
+// Returns an error if capacity is less than or equal to zero, or either
+// ratio is not in (0, 1].
+func New2Q[K comparable, V any](capacity int, recentRatio, ghostRatio float64) (*TwoQCache[K, V], error) {
+	if capacity <= 0 {
+		return nil, errors.New("capacity must be greater than 0")
+	}
+	if recentRatio <= 0 || recentRatio > 1 {
+		return nil, errors.New("recentRatio must be in (0, 1]")
+	}
+	if ghostRatio <= 0 || ghostRatio > 1 {
+		return nil, errors.New("ghostRatio must be in (0, 1]")
+	}
+
+	recentCap := int(float64(capacity) * recentRatio)
+	if recentCap < 1 {
+		recentCap = 1
+	}
+	ghostCap := int(float64(capacity) * ghostRatio)
+	if ghostCap < 1 {
+		ghostCap = 1
+	}
+
+	return &TwoQCache[K, V]{
+		capacity:    capacity,
+		recentCap:   recentCap,
+		ghostCap:    ghostCap,
+		recent:      list.New(),
+		frequent:    list.New(),
+		recentEvict: list.New(),
+
+		recentDict:      make(map[K]*list.Element),
+		frequentDict:    make(map[K]*list.Element),
+		recentEvictDict: make(map[K]*list.Element),
+	}, nil
+}
+
+// Get returns the value associated with the given key if present. A hit in
+// recent promotes the entry to frequent; a hit in frequent just moves it to
+// the front. The second return value is true if the key was found.
+func (c *TwoQCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		var zero V
+		return zero, false
+	}
+
+	if ele, ok := c.frequentDict[key]; ok {
+		c.frequent.MoveToFront(ele)
+		return ele.Value.(*twoQEntry[K, V]).value, true
+	}
+
+	if ele, ok := c.recentDict[key]; ok {
+		ent := ele.Value.(*twoQEntry[K, V])
+		c.recent.Remove(ele)
+		delete(c.recentDict, key)
+		c.pushFrequent(ent.key, ent.value)
+		return ent.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Put inserts or updates the key-value pair into the cache. A key already in
+// frequent is moved to the front there. A key found in the recentEvict ghost
+// list is promoted straight to frequent (it has been seen twice now). A
+// brand new key goes to recent.
+func (c *TwoQCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	if ele, ok := c.frequentDict[key]; ok {
+		ele.Value.(*twoQEntry[K, V]).value = value
+		c.frequent.MoveToFront(ele)
+		return
+	}
+
+	if ele, ok := c.recentEvictDict[key]; ok {
+		c.recentEvict.Remove(ele)
+		delete(c.recentEvictDict, key)
+		c.pushFrequent(key, value)
+		return
+	}
+
+	if ele, ok := c.recentDict[key]; ok {
+		ele.Value.(*twoQEntry[K, V]).value = value
+		c.recent.MoveToFront(ele)
+		return
+	}
+
+	ent := &twoQEntry[K, V]{key: key, value: value}
+	c.recentDict[key] = c.recent.PushFront(ent)
+
+	for c.recent.Len() > c.recentCap {
+		c.evictFromRecent()
+	}
+	for c.recent.Len()+c.frequent.Len() > c.capacity {
+		c.evictFromFrequent()
+	}
+}
+
+// pushFrequent inserts key/value at the front of frequent, then evicts the
+// LRU entries of frequent while the combined size exceeds capacity. It must
+// be called with the lock held.
+func (c *TwoQCache[K, V]) pushFrequent(key K, value V) {
+	ent := &twoQEntry[K, V]{key: key, value: value}
+	c.frequentDict[key] = c.frequent.PushFront(ent)
+
+	for c.recent.Len()+c.frequent.Len() > c.capacity {
+		c.evictFromFrequent()
+	}
+}
+
+// evictFromRecent evicts the LRU entry of recent, recording its key in the
+// recentEvict ghost list. It must be called with the lock held.
+func (c *TwoQCache[K, V]) evictFromRecent() {
+	ele := c.recent.Back()
+	if ele == nil {
+		return
+	}
+	ent := ele.Value.(*twoQEntry[K, V])
+	c.recent.Remove(ele)
+	delete(c.recentDict, ent.key)
+
+	c.recentEvictDict[ent.key] = c.recentEvict.PushFront(ent.key)
+	for c.recentEvict.Len() > c.ghostCap {
+		ge := c.recentEvict.Back()
+		delete(c.recentEvictDict, ge.Value.(K))
+		c.recentEvict.Remove(ge)
+	}
+}
+
+// evictFromFrequent evicts the LRU entry of frequent. It must be called
+// with the lock held.
+func (c *TwoQCache[K, V]) evictFromFrequent() {
+	ele := c.frequent.Back()
+	if ele == nil {
+		return
+	}
+	ent := ele.Value.(*twoQEntry[K, V])
+	c.frequent.Remove(ele)
+	delete(c.frequentDict, ent.key)
+}
+
+// Clear removes all entries from the cache.
+func (c *TwoQCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	c.recent = list.New()
+	c.frequent = list.New()
+	c.recentEvict = list.New()
+	c.recentDict = make(map[K]*list.Element)
+	c.frequentDict = make(map[K]*list.Element)
+	c.recentEvictDict = make(map[K]*list.Element)
+}
+
+// Len returns the current number of live entries in the cache (the
+// recentEvict ghost list is not counted since it holds no values).
+func (c *TwoQCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.recent.Len() + c.frequent.Len()
+}
+
+// Keys returns a slice of all live keys, recent entries (LRU to MRU)
+// followed by frequent entries (LRU to MRU).
+func (c *TwoQCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	keys := make([]K, 0, c.recent.Len()+c.frequent.Len())
+	for e := c.recent.Back(); e != nil; e = e.Prev() {
+		keys = append(keys, e.Value.(*twoQEntry[K, V]).key)
+	}
+	for e := c.frequent.Back(); e != nil; e = e.Prev() {
+		keys = append(keys, e.Value.(*twoQEntry[K, V]).key)
+	}
+	return keys
+}
+
+func (c *TwoQCache[K, V]) Close() {
+	c.once.Do(func() {
+		c.mu.Lock()
+		c.closed = true
+		c.mu.Unlock()
+	})
+}
@@ -0,0 +1,180 @@
+package lru
+
+import "testing"
+
+func TestTwoQBasicPutGet(t *testing.T) {
+	cache, err := New2Q[string, int](4, 0.5, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	if v, ok := cache.Get("a"); ok {
+		t.Errorf("expected not found, but got %v", v)
+	}
+
+	cache.Put("a", 1)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected found (1), got (%v, %v)", v, ok)
+	}
+}
+
+func TestTwoQPromotesOnSecondHit(t *testing.T) {
+	cache, err := New2Q[string, int](4, 0.5, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	// First Get promotes a from recent to frequent.
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected found (1), got (%v, %v)", v, ok)
+	}
+	if _, ok := cache.frequentDict["a"]; !ok {
+		t.Errorf("expected a to be promoted to frequent")
+	}
+}
+
+func TestTwoQScanResistance(t *testing.T) {
+	// capacity 4, recentRatio 0.5 => recent holds at most 2 before spilling.
+	cache, err := New2Q[int, int](4, 0.5, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	// Promote 1 and 2 to frequent with a second touch each.
+	cache.Put(1, 1)
+	cache.Get(1)
+	cache.Put(2, 2)
+	cache.Get(2)
+
+	// A long one-shot scan through recent-only keys should not evict the
+	// frequent working set.
+	for i := 100; i < 200; i++ {
+		cache.Put(i, i)
+	}
+
+	if v, ok := cache.Get(1); !ok || v != 1 {
+		t.Errorf("expected frequent key 1 to survive the scan, got (%v, %v)", v, ok)
+	}
+	if v, ok := cache.Get(2); !ok || v != 2 {
+		t.Errorf("expected frequent key 2 to survive the scan, got (%v, %v)", v, ok)
+	}
+}
+
+func TestTwoQRecentCapTrimsBeforeGhosting(t *testing.T) {
+	// capacity 10, recentRatio 0.2 => recent holds at most 2.
+	cache, err := New2Q[int, int](10, 0.2, 1.0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+
+	if got := cache.recent.Len(); got != 2 {
+		t.Errorf("expected recent capped at 2, got %d", got)
+	}
+	if _, ok := cache.recentEvictDict[1]; !ok {
+		t.Errorf("expected key 1 to have been ghosted when recent overflowed")
+	}
+}
+
+func TestTwoQGhostCapTrims(t *testing.T) {
+	// capacity 10, recentRatio 0.1 (recent holds 1), ghostRatio 0.2 (ghost holds 2).
+	cache, err := New2Q[int, int](10, 0.1, 0.2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	for i := 1; i <= 4; i++ {
+		cache.Put(i, i)
+	}
+
+	if got := cache.recentEvict.Len(); got != 2 {
+		t.Errorf("expected recentEvict capped at 2, got %d", got)
+	}
+	if _, ok := cache.recentEvictDict[1]; ok {
+		t.Errorf("expected the oldest ghost entry (key 1) to be trimmed")
+	}
+	if _, ok := cache.recentEvictDict[3]; !ok {
+		t.Errorf("expected the most recently ghosted entry (key 3) to remain")
+	}
+}
+
+func TestTwoQPromotesFromGhostOnPut(t *testing.T) {
+	// capacity 10, recentRatio 0.1 => recent holds only 1, so the second Put
+	// immediately evicts the first into the recentEvict ghost list.
+	cache, err := New2Q[string, int](10, 0.1, 1.0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Put("b", 2) // evicts a into recentEvict
+
+	if _, ok := cache.recentEvictDict["a"]; !ok {
+		t.Errorf("expected a to be in the recentEvict ghost list")
+	}
+
+	// Re-Put of a ghosted key is a second sighting, so it should be promoted
+	// straight to frequent rather than going back through recent.
+	cache.Put("a", 10)
+
+	if _, ok := cache.recentEvictDict["a"]; ok {
+		t.Errorf("expected a to be removed from recentEvict once promoted")
+	}
+	if _, ok := cache.frequentDict["a"]; !ok {
+		t.Errorf("expected a to be promoted straight to frequent")
+	}
+	if v, ok := cache.Get("a"); !ok || v != 10 {
+		t.Errorf("expected found (10), got (%v, %v)", v, ok)
+	}
+}
+
+func TestTwoQClear(t *testing.T) {
+	cache, err := New2Q[string, int](4, 0.5, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cache.Close()
+
+	cache.Put("a", 1)
+	cache.Get("a") // promote a to frequent
+	cache.Put("b", 2)
+	cache.Clear()
+
+	if cache.Len() != 0 {
+		t.Errorf("expected len 0 after Clear, got %d", cache.Len())
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to be gone after Clear")
+	}
+	if len(cache.frequentDict) != 0 || len(cache.recentDict) != 0 || len(cache.recentEvictDict) != 0 {
+		t.Errorf("expected all internal dicts empty after Clear")
+	}
+}
+
+func TestTwoQOperationsNoOpAfterClose(t *testing.T) {
+	cache, err := New2Q[string, int](4, 0.5, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cache.Put("a", 1)
+	cache.Close()
+
+	if v, ok := cache.Get("a"); ok {
+		t.Errorf("expected Get to report nothing after Close, got %v", v)
+	}
+	cache.Put("b", 2) // must not panic or resurrect the cache
+	if cache.Keys() != nil {
+		t.Errorf("expected Keys to return nil after Close")
+	}
+}